@@ -0,0 +1,84 @@
+package kitsu
+
+import (
+	"context"
+	"reflect"
+)
+
+// Anime represents a Kitsu anime resource.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/anime
+type Anime struct {
+	ID             string `jsonapi:"primary,anime"`
+	CanonicalTitle string `jsonapi:"attr,canonicalTitle,omitempty"`
+	Synopsis       string `jsonapi:"attr,synopsis,omitempty"`
+	AverageRating  string `jsonapi:"attr,averageRating,omitempty"`
+	EpisodeCount   int    `jsonapi:"attr,episodeCount,omitempty"`
+}
+
+// AnimeService handles communication with the anime related methods of the
+// Kitsu API.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/anime
+type AnimeService service
+
+// Show returns the anime identified by id.
+//
+// Show is a thin wrapper around ShowWithContext using context.Background,
+// kept for callers that don't need cancellation.
+func (s *AnimeService) Show(id string) (*Anime, *Response, error) {
+	return s.ShowWithContext(context.Background(), id)
+}
+
+// ShowWithContext behaves like Show but carries ctx through the request, so
+// a slow response can be cancelled instead of hanging the caller.
+func (s *AnimeService) ShowWithContext(ctx context.Context, id string) (*Anime, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "anime/"+id, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var a Anime
+	resp, err := s.client.DoWithContext(ctx, req, &a)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &a, resp, nil
+}
+
+// List returns a list of anime, optionally filtered, sorted, paginated and
+// expanded according to opt.
+//
+// List is a thin wrapper around ListWithContext using context.Background,
+// kept for callers that don't need cancellation.
+func (s *AnimeService) List(opt *Options) ([]*Anime, *Response, error) {
+	return s.ListWithContext(context.Background(), opt)
+}
+
+// ListWithContext behaves like List but carries ctx through the request, so
+// a slow response can be cancelled instead of hanging the caller.
+func (s *AnimeService) ListWithContext(ctx context.Context, opt *Options) ([]*Anime, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "anime", nil, opt.urlOptions()...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, resp, err := s.client.DoManyWithContext(ctx, req, reflect.TypeOf(new(Anime)))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var anime []*Anime
+	for _, a := range v {
+		anime = append(anime, a.(*Anime))
+	}
+	return anime, resp, nil
+}
+
+// ListPages returns a Pager over the anime listing described by opt. Use
+// AnimePager.Next/Prev to follow the server's links.next/links.prev cursors
+// instead of reconstructing page requests from Options.
+func (s *AnimeService) ListPages(opt *Options) *AnimePager {
+	req, err := s.client.NewRequest("GET", "anime", nil, opt.urlOptions()...)
+	return &AnimePager{newPager(s.client, reflect.TypeOf(new(Anime)), req, err)}
+}
@@ -0,0 +1,222 @@
+// Package auth implements Kitsu's OAuth2 password-grant authentication flow
+// (https://kitsu.docs.apiary.io/#reference/authentication) and an
+// http.RoundTripper that keeps the resulting access token fresh across
+// requests.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTokenURL = "https://kitsu.io/api/oauth/token"
+
+	// defaultRefreshWindow is how far ahead of a token's expiry Transport
+	// will proactively refresh it.
+	defaultRefreshWindow = 30 * time.Second
+)
+
+// Token is an OAuth2 access token as returned by Kitsu's
+// /api/oauth/token endpoint.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	Scope        string `json:"scope"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// Expiry returns the time at which t expires.
+func (t *Token) Expiry() time.Time {
+	return time.Unix(t.CreatedAt, 0).Add(time.Duration(t.ExpiresIn) * time.Second)
+}
+
+// expired reports whether t is nil, empty, or will expire within d of now.
+func (t *Token) expired(d time.Duration) bool {
+	if t == nil || t.AccessToken == "" {
+		return true
+	}
+	return time.Now().Add(d).After(t.Expiry())
+}
+
+// TokenSource supplies the current token for a Transport. Callers can
+// implement TokenSource over their own storage (file, database, session
+// store, ...) to decide how tokens are persisted between runs.
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// TokenSaver is implemented by a TokenSource that wants to be notified of a
+// refreshed token so it can persist it. Transport calls SaveToken after every
+// successful refresh.
+type TokenSaver interface {
+	SaveToken(*Token) error
+}
+
+// StaticTokenSource returns a TokenSource that always returns t.
+func StaticTokenSource(t *Token) TokenSource {
+	return staticTokenSource{t}
+}
+
+type staticTokenSource struct{ token *Token }
+
+func (s staticTokenSource) Token() (*Token, error) { return s.token, nil }
+
+// Config holds the OAuth2 client credentials used to talk to Kitsu's token
+// endpoint.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+
+	// HTTPClient is used to perform the token requests. It defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// PasswordCredentialsToken exchanges a Kitsu username and password for an
+// access token using the OAuth2 "password" grant.
+func (c *Config) PasswordCredentialsToken(ctx context.Context, username, password string) (*Token, error) {
+	return c.fetchToken(ctx, url.Values{
+		"grant_type": {"password"},
+		"username":   {username},
+		"password":   {password},
+	})
+}
+
+// RefreshToken exchanges a refresh token for a new access token using the
+// OAuth2 "refresh_token" grant.
+func (c *Config) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	return c.fetchToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+func (c *Config) fetchToken(ctx context.Context, v url.Values) (*Token, error) {
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	req, err := http.NewRequest(http.MethodPost, defaultTokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("auth: token request failed: %d %s", resp.StatusCode, body)
+	}
+
+	var t Token
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Transport is an http.RoundTripper that injects "Authorization: Bearer ..."
+// into every outgoing request, transparently refreshing the token via Config
+// once it is within RefreshWindow of expiring.
+type Transport struct {
+	Config *Config
+	Source TokenSource
+
+	// Base is the underlying RoundTripper used to perform the authenticated
+	// request. It defaults to http.DefaultTransport.
+	Base http.RoundTripper
+
+	// RefreshWindow is how far ahead of expiry the token is refreshed. It
+	// defaults to 30 seconds.
+	RefreshWindow time.Duration
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.currentToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req)
+	req2.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req2)
+}
+
+func (t *Transport) currentToken(ctx context.Context) (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token == nil {
+		tok, err := t.Source.Token()
+		if err != nil {
+			return nil, err
+		}
+		if tok == nil {
+			return nil, fmt.Errorf("auth: Source returned no token")
+		}
+		t.token = tok
+	}
+
+	window := t.RefreshWindow
+	if window == 0 {
+		window = defaultRefreshWindow
+	}
+
+	if t.token.expired(window) {
+		tok, err := t.Config.RefreshToken(ctx, t.token.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		t.token = tok
+		if saver, ok := t.Source.(TokenSaver); ok {
+			if err := saver.SaveToken(tok); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return t.token, nil
+}
+
+// cloneRequest returns a shallow copy of req with its own Header map, so that
+// setting the Authorization header doesn't mutate the caller's request.
+func cloneRequest(req *http.Request) *http.Request {
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, vv := range req.Header {
+		req2.Header[k] = append([]string(nil), vv...)
+	}
+	return req2
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransport_RoundTrip_emptyTokenSource(t *testing.T) {
+	tr := &Transport{
+		Config: &Config{ClientID: "id", ClientSecret: "secret"},
+		Source: StaticTokenSource(nil),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://kitsu.io/api/edge/users", nil)
+
+	_, err := tr.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error when the TokenSource returns no token, got nil")
+	}
+}
@@ -2,17 +2,23 @@ package kitsu
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/nstratos/jsonapi"
+	"github.com/robertgzr/go-kitsu/kitsu/auth"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -30,8 +36,26 @@ type Client struct {
 
 	common service
 
-	Anime *AnimeService
-	User  *UserService
+	Anime          *AnimeService
+	User           *UserService
+	LibraryEntries *LibraryEntryService
+
+	// Limiter paces outgoing requests made by Do/DoMany. If nil, a default
+	// implementation backed by golang.org/x/time/rate is built lazily from
+	// RateLimit the first time it is needed. See also RateLimit.
+	Limiter Limiter
+
+	// RateLimit sizes the token bucket used by the default Limiter. The
+	// zero value disables client-side throttling.
+	RateLimit RateLimit
+
+	// MaxRetries caps how many times a 429/503 response is retried before
+	// it is returned to the caller as an error. The zero value means
+	// defaultMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	limiterOnce    sync.Once
+	defaultLimiter Limiter
 }
 
 type service struct {
@@ -61,7 +85,7 @@ func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
-	baseURL, _ := url.Parse(defaultBaseURL)
+	baseURL, _ := url.Parse(defaultBaseURL + defaultAPIVersion)
 
 	c := &Client{client: httpClient, BaseURL: baseURL}
 
@@ -69,10 +93,23 @@ func NewClient(httpClient *http.Client) *Client {
 
 	c.Anime = (*AnimeService)(&c.common)
 	c.User = (*UserService)(&c.common)
+	c.LibraryEntries = (*LibraryEntryService)(&c.common)
 
 	return c
 }
 
+// NewAuthenticatedClient returns a new kitsu.io API client whose requests
+// carry a bearer token sourced from ts, refreshed via cfg as needed. This is
+// the prerequisite for write endpoints (library entries, follows, reactions)
+// that Kitsu gates behind a bearer token; ts is typically a
+// auth.StaticTokenSource over the token returned by a single call to
+// cfg.PasswordCredentialsToken. See package auth.
+func NewAuthenticatedClient(cfg *auth.Config, ts auth.TokenSource) *Client {
+	return NewClient(&http.Client{
+		Transport: &auth.Transport{Config: cfg, Source: ts},
+	})
+}
+
 // urlOption allows to specify URL parameters to the Kitsu API to change the
 // data that will be retrieved.
 type urlOption func(v *url.Values)
@@ -167,6 +204,17 @@ func Include(relationships ...string) urlOption {
 	}
 }
 
+// NewRequestWithContext creates an API request identically to NewRequest but
+// attaches ctx to the outbound request via (*http.Request).WithContext, so
+// that it can be cancelled by DoWithContext/DoManyWithContext.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}, opts ...urlOption) (*http.Request, error) {
+	req, err := c.NewRequest(method, urlStr, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
 // NewRequest creates an API request. If a relative URL is provided in urlStr,
 // it will be resolved relative to the BaseURL of the Client. Relative URLs
 // should always be specified without a preceding slash. If body is specified,
@@ -211,6 +259,48 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...url
 	return req, nil
 }
 
+// NewJSONAPIRequest creates an API request whose body is serialized as a
+// JSON:API resource object ({"data":{"type":...,"attributes":...,"relationships":...}})
+// using jsonapi.MarshalPayload, rather than the plain JSON encoding used by
+// NewRequest. This is what Kitsu expects for creates and updates. Fields
+// tagged `jsonapi:"attr,...,omitempty"` are omitted from attributes when
+// zero-valued, which makes NewJSONAPIRequest safe to use for partial PATCH
+// updates.
+func (c *Client) NewJSONAPIRequest(method, urlStr string, payload interface{}) (*http.Request, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	u := c.BaseURL.ResolveReference(rel)
+
+	buf := new(bytes.Buffer)
+	if err := jsonapi.MarshalPayload(buf, payload); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", defaultMediaType)
+	req.Header.Set("Accept", defaultMediaType)
+
+	return req, nil
+}
+
+// NewJSONAPIRequestWithContext creates an API request identically to
+// NewJSONAPIRequest but attaches ctx to the outbound request via
+// (*http.Request).WithContext, so that it can be cancelled by
+// DoWithContext.
+func (c *Client) NewJSONAPIRequestWithContext(ctx context.Context, method, urlStr string, payload interface{}) (*http.Request, error) {
+	req, err := c.NewJSONAPIRequest(method, urlStr, payload)
+	if err != nil {
+		return nil, err
+	}
+	return req.WithContext(ctx), nil
+}
+
 // Response is a Kitsu API response. It wraps the standard http.Response
 // returned from the request and provides access to pagination offsets for
 // responses that return an array of results.
@@ -221,19 +311,223 @@ type Response struct {
 	PrevOffset  int
 	FirstOffset int
 	LastOffset  int
+
+	// NextLink and PrevLink hold the verbatim links.next/links.prev URLs
+	// returned by the server, if any. Pager uses these instead of
+	// reconstructing a page request from Options, so cursor-style paging
+	// keeps working even if a resource switches from offset to keyset
+	// pagination.
+	NextLink string
+	PrevLink string
+
+	// Rate is Kitsu's view of the caller's current quota, parsed from the
+	// X-RateLimit-* response headers.
+	Rate Rate
 }
 
 func newResponse(r *http.Response) *Response {
-	return &Response{Response: r}
+	return &Response{Response: r, Rate: parseRate(r)}
+}
+
+// Limiter paces outgoing requests. Wait should block until a request may
+// proceed, or return ctx.Err() if ctx is done first. The default
+// implementation used by Client is backed by golang.org/x/time/rate;
+// *rate.Limiter itself already satisfies this interface.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RateLimit sizes the token bucket used by a Client's default Limiter. The
+// zero value disables client-side throttling.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate at which tokens are added to
+	// the bucket.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests allowed in a single burst. If
+	// zero, it defaults to 1.
+	Burst int
+}
+
+// Rate represents Kitsu's view of the caller's current quota, parsed from
+// the X-RateLimit-* response headers.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func parseRate(r *http.Response) Rate {
+	var rl Rate
+	if limit := r.Header.Get("X-RateLimit-Limit"); limit != "" {
+		if n, err := strconv.Atoi(limit); err == nil {
+			rl.Limit = n
+		}
+	}
+	if remaining := r.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		if n, err := strconv.Atoi(remaining); err == nil {
+			rl.Remaining = n
+		}
+	}
+	if reset := r.Header.Get("X-RateLimit-Reset"); reset != "" {
+		if n, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			rl.Reset = time.Unix(n, 0)
+		}
+	}
+	return rl
+}
+
+type noopLimiter struct{}
+
+func (noopLimiter) Wait(ctx context.Context) error { return ctx.Err() }
+
+// limiter returns c.Limiter if set, otherwise lazily builds and caches a
+// default Limiter sized from c.RateLimit.
+func (c *Client) limiter() Limiter {
+	if c.Limiter != nil {
+		return c.Limiter
+	}
+	c.limiterOnce.Do(func() {
+		if c.RateLimit.RequestsPerSecond <= 0 {
+			c.defaultLimiter = noopLimiter{}
+			return
+		}
+		burst := c.RateLimit.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		c.defaultLimiter = rate.NewLimiter(rate.Limit(c.RateLimit.RequestsPerSecond), burst)
+	})
+	return c.defaultLimiter
+}
+
+// defaultMaxRetries is used in place of a zero-valued Client.MaxRetries.
+const defaultMaxRetries = 3
+
+// doWithRetry consults c.limiter() before dispatching req, then retries
+// 429 and 503 responses honoring their Retry-After header (falling back to
+// exponential backoff with jitter when absent), up to c.MaxRetries times.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	maxRetries := c.MaxRetries
+	switch {
+	case maxRetries == 0:
+		maxRetries = defaultMaxRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// Without GetBody we cannot safely replay a body that the first
+		// attempt may already have drained, so don't retry at all rather
+		// than risk silently resending a truncated body.
+		maxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if err := c.limiter().Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+		if !retryable || attempt >= maxRetries {
+			return resp, nil
+		}
+
+		wait := retryDelay(resp, attempt)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay determines how long to wait before retrying resp's request: the
+// server's Retry-After header if present (either delay-seconds or an
+// HTTP-date), otherwise exponential backoff with jitter based on attempt.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(ra); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d
+			}
+		}
+	}
+
+	// Cap the shift so backoff can't overflow into a negative duration for
+	// a large MaxRetries.
+	shift := attempt
+	const maxShift = 6 // 250ms << 6 == 16s
+	if shift > maxShift {
+		shift = maxShift
+	}
+	backoff := (250 * time.Millisecond) << uint(shift)
+	return backoff + time.Duration(rand.Int63n(int64(backoff)+1))
+}
+
+// RateLimits returns Kitsu's current view of the caller's quota by issuing a
+// minimal request against the API root and inspecting its X-RateLimit-*
+// response headers.
+func (c *Client) RateLimits(ctx context.Context) (*Rate, error) {
+	req, err := c.NewRequestWithContext(ctx, "HEAD", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doWithRetry(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	rl := parseRate(resp)
+	return &rl, nil
 }
 
 // Do sends an API request and returns the API response. If an API error has
 // occurred both the response and the error will be returned in case the caller
 // wishes to further inspect the response. If v is passed as an argument, then
 // the API response is JSON decoded and stored to v.
+//
+// Do is a thin wrapper around DoWithContext using context.Background, kept
+// for callers that don't need cancellation.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	return c.DoWithContext(context.Background(), req, v)
+}
+
+// DoWithContext behaves like Do but carries ctx through the request and the
+// decoding of the response body. If ctx is cancelled or its deadline expires
+// while the request is in flight or while the response body is being
+// decoded, DoWithContext returns ctx.Err() instead of blocking or returning
+// an opaque "read on closed body" error.
+func (c *Client) DoWithContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	resp, err := c.doWithRetry(ctx, req.WithContext(ctx))
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
 
@@ -245,14 +539,34 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	}
 
 	if v != nil {
-		err = jsonapi.UnmarshalPayload(resp.Body, v)
+		body := newCtxReadCloser(ctx, resp.Body)
+		defer body.Close()
+		err = jsonapi.UnmarshalPayload(body, v)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			}
+		}
 	}
 	return newResponse(resp), err
 }
 
+// DoMany is a thin wrapper around DoManyWithContext using context.Background,
+// kept for callers that don't need cancellation.
 func (c *Client) DoMany(req *http.Request, t reflect.Type) ([]interface{}, *Response, error) {
-	resp, err := c.client.Do(req)
+	return c.DoManyWithContext(context.Background(), req, t)
+}
+
+// DoManyWithContext behaves like DoMany but carries ctx through the request
+// and the decoding of the response body, returning ctx.Err() as soon as ctx
+// is cancelled or its deadline expires instead of blocking on a stalled
+// decode.
+func (c *Client) DoManyWithContext(ctx context.Context, req *http.Request, t reflect.Type) ([]interface{}, *Response, error) {
+	resp, err := c.doWithRetry(ctx, req.WithContext(ctx))
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
 		return nil, nil, err
 	}
 
@@ -263,13 +577,25 @@ func (c *Client) DoMany(req *http.Request, t reflect.Type) ([]interface{}, *Resp
 		return nil, newResponse(resp), err
 	}
 
+	body := newCtxReadCloser(ctx, resp.Body)
+	defer body.Close()
+
 	var v []interface{}
 	var links *jsonapi.Links
-	v, links, err = jsonapi.UnmarshalManyPayloadWithLinks(resp.Body, t)
+	v, links, err = jsonapi.UnmarshalManyPayloadWithLinks(body, t)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, newResponse(resp), ctxErr
+		}
 		return nil, newResponse(resp), err
 	}
 
+	if links == nil {
+		// No "links" object at all, e.g. the last page of a listing: treat
+		// it the same as a links object with no entries.
+		links = &jsonapi.Links{}
+	}
+
 	o, err := parseOffset(*links)
 	if err != nil {
 		return nil, newResponse(resp), err
@@ -280,10 +606,53 @@ func (c *Client) DoMany(req *http.Request, t reflect.Type) ([]interface{}, *Resp
 		LastOffset:  o.last,
 		PrevOffset:  o.prev,
 		NextOffset:  o.next,
+		NextLink:    linkString(*links, "next"),
+		PrevLink:    linkString(*links, "prev"),
+		Rate:        parseRate(resp),
 	}
 	return v, response, err
 }
 
+// ctxReadCloser wraps an io.ReadCloser so that a Read blocked on a stalled
+// connection is unblocked as soon as ctx is done: a background goroutine
+// closes the underlying body on ctx.Done, which turns the pending Read into
+// an error, and that error is then reported to the caller as ctx.Err(). This
+// mirrors the read-deadline pattern used by netstack's gonet adapter, where a
+// cancel channel closed on cancellation short-circuits blocking I/O.
+type ctxReadCloser struct {
+	ctx    context.Context
+	rc     io.ReadCloser
+	cancel chan struct{}
+	once   sync.Once
+}
+
+func newCtxReadCloser(ctx context.Context, rc io.ReadCloser) *ctxReadCloser {
+	c := &ctxReadCloser{ctx: ctx, rc: rc, cancel: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+			rc.Close()
+		case <-c.cancel:
+		}
+	}()
+	return c
+}
+
+func (c *ctxReadCloser) Read(p []byte) (int, error) {
+	n, err := c.rc.Read(p)
+	if err != nil {
+		if ctxErr := c.ctx.Err(); ctxErr != nil {
+			return n, ctxErr
+		}
+	}
+	return n, err
+}
+
+func (c *ctxReadCloser) Close() error {
+	c.once.Do(func() { close(c.cancel) })
+	return c.rc.Close()
+}
+
 // ErrorResponse reports one or more errors caused by an API request.
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
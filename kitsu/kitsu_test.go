@@ -0,0 +1,75 @@
+package kitsu
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// values is a shorthand used by testFormValues to describe expected URL
+// query parameters.
+type values map[string]string
+
+var (
+	// mux is the HTTP request multiplexer used with the test server.
+	mux *http.ServeMux
+
+	// client is the Kitsu client being tested, configured to talk to the
+	// test server.
+	client *Client
+
+	// server is a test HTTP server used to provide mock API responses.
+	server *httptest.Server
+)
+
+// setup sets up a test HTTP server along with a kitsu.Client that is
+// configured to talk to that test server. Tests should register handlers on
+// mux which provide mock responses for the API method being tested.
+func setup() {
+	mux = http.NewServeMux()
+	server = httptest.NewServer(mux)
+
+	client = NewClient(nil)
+	u, _ := url.Parse(server.URL + "/" + defaultAPIVersion)
+	client.BaseURL = u
+}
+
+// teardown closes the test HTTP server started by setup.
+func teardown() {
+	server.Close()
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+func testHeader(t *testing.T, r *http.Request, header, want string) {
+	t.Helper()
+	if got := r.Header.Get(header); got != want {
+		t.Errorf("Header.Get(%q) returned %q, want %q", header, got, want)
+	}
+}
+
+func testFormValues(t *testing.T, r *http.Request, want values) {
+	t.Helper()
+	if err := r.ParseForm(); err != nil {
+		t.Fatalf("ParseForm(): %v", err)
+	}
+
+	got := url.Values{}
+	for k, v := range r.Form {
+		got[k] = v
+	}
+	wantValues := url.Values{}
+	for k, v := range want {
+		wantValues.Set(k, v)
+	}
+
+	if got.Encode() != wantValues.Encode() {
+		t.Errorf("Request parameters: %v, want %v", got, wantValues)
+	}
+}
@@ -0,0 +1,110 @@
+package kitsu
+
+import "context"
+
+// LibraryEntry represents an entry in a user's library, tracking their
+// progress, status and rating for a piece of media.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/library-entries
+type LibraryEntry struct {
+	ID string `jsonapi:"primary,libraryEntries"`
+
+	Status       string `jsonapi:"attr,status,omitempty"`
+	Progress     int    `jsonapi:"attr,progress,omitempty"`
+	Reconsuming  bool   `jsonapi:"attr,reconsuming,omitempty"`
+	RatingTwenty string `jsonapi:"attr,ratingTwenty,omitempty"`
+
+	User  *libraryEntryUser  `jsonapi:"relation,user,omitempty"`
+	Anime *libraryEntryAnime `jsonapi:"relation,anime,omitempty"`
+}
+
+// libraryEntryUser links a LibraryEntry to an existing user by ID.
+type libraryEntryUser struct {
+	ID string `jsonapi:"primary,users"`
+}
+
+// libraryEntryAnime links a LibraryEntry to an existing anime by ID.
+type libraryEntryAnime struct {
+	ID string `jsonapi:"primary,anime"`
+}
+
+// LibraryEntryService handles communication with the library entry related
+// methods of the Kitsu API.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/library-entries
+type LibraryEntryService service
+
+// Create creates a new library entry. entry.User and entry.Anime must be set
+// to link the new entry to an existing user and anime. Create requires an
+// authenticated Client; see NewAuthenticatedClient.
+//
+// Create is a thin wrapper around CreateWithContext using
+// context.Background, kept for callers that don't need cancellation.
+func (s *LibraryEntryService) Create(entry *LibraryEntry) (*LibraryEntry, *Response, error) {
+	return s.CreateWithContext(context.Background(), entry)
+}
+
+// CreateWithContext behaves like Create but carries ctx through the
+// request, so a slow response can be cancelled instead of hanging the
+// caller.
+func (s *LibraryEntryService) CreateWithContext(ctx context.Context, entry *LibraryEntry) (*LibraryEntry, *Response, error) {
+	req, err := s.client.NewJSONAPIRequestWithContext(ctx, "POST", "library-entries", entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var e LibraryEntry
+	resp, err := s.client.DoWithContext(ctx, req, &e)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &e, resp, nil
+}
+
+// Update applies patch as a partial update to the library entry identified by
+// id. Fields left at their zero value in patch are omitted from the request
+// and therefore left untouched server-side.
+//
+// Update is a thin wrapper around UpdateWithContext using
+// context.Background, kept for callers that don't need cancellation.
+func (s *LibraryEntryService) Update(id string, patch *LibraryEntry) (*LibraryEntry, *Response, error) {
+	return s.UpdateWithContext(context.Background(), id, patch)
+}
+
+// UpdateWithContext behaves like Update but carries ctx through the
+// request, so a slow response can be cancelled instead of hanging the
+// caller.
+func (s *LibraryEntryService) UpdateWithContext(ctx context.Context, id string, patch *LibraryEntry) (*LibraryEntry, *Response, error) {
+	patch.ID = id
+
+	req, err := s.client.NewJSONAPIRequestWithContext(ctx, "PATCH", "library-entries/"+id, patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var e LibraryEntry
+	resp, err := s.client.DoWithContext(ctx, req, &e)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &e, resp, nil
+}
+
+// Delete removes the library entry identified by id.
+//
+// Delete is a thin wrapper around DeleteWithContext using
+// context.Background, kept for callers that don't need cancellation.
+func (s *LibraryEntryService) Delete(id string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext behaves like Delete but carries ctx through the
+// request, so a slow response can be cancelled instead of hanging the
+// caller.
+func (s *LibraryEntryService) DeleteWithContext(ctx context.Context, id string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", "library-entries/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.DoWithContext(ctx, req, nil)
+}
@@ -0,0 +1,128 @@
+package kitsu
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestLibraryEntryService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", defaultMediaType)
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+
+		var payload struct {
+			Data struct {
+				Type          string                 `json:"type"`
+				Attributes    map[string]interface{} `json:"attributes"`
+				Relationships map[string]struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("request body is not a JSON:API resource object: %v (%s)", err, body)
+		}
+
+		if got, want := payload.Data.Type, "libraryEntries"; got != want {
+			t.Errorf("request body data.type = %q, want %q", got, want)
+		}
+		if got, want := payload.Data.Attributes["status"], "current"; got != want {
+			t.Errorf("request body data.attributes.status = %v, want %v", got, want)
+		}
+		if got, want := payload.Data.Relationships["user"].Data.ID, "1"; got != want {
+			t.Errorf("request body data.relationships.user.data.id = %v, want %v", got, want)
+		}
+		if got, want := payload.Data.Relationships["anime"].Data.ID, "2"; got != want {
+			t.Errorf("request body data.relationships.anime.data.id = %v, want %v", got, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"id":"9","type":"libraryEntries","attributes":{"status":"current","progress":5}}}`)
+	})
+
+	entry := &LibraryEntry{
+		Status:   "current",
+		Progress: 5,
+		User:     &libraryEntryUser{ID: "1"},
+		Anime:    &libraryEntryAnime{ID: "2"},
+	}
+
+	got, _, err := client.LibraryEntries.Create(entry)
+	if err != nil {
+		t.Fatalf("LibraryEntries.Create returned error: %v", err)
+	}
+
+	if want := "9"; got.ID != want {
+		t.Errorf("LibraryEntries.Create ID = %q, want %q", got.ID, want)
+	}
+}
+
+func TestLibraryEntryService_Update_omitsZeroAttributes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Content-Type", defaultMediaType)
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+
+		var payload struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("request body is not a JSON:API resource object: %v (%s)", err, body)
+		}
+
+		if _, ok := payload.Data.Attributes["progress"]; !ok {
+			t.Errorf("request body data.attributes should contain progress, got %v", payload.Data.Attributes)
+		}
+		if _, ok := payload.Data.Attributes["status"]; ok {
+			t.Errorf("request body data.attributes should omit zero-valued status, got %v", payload.Data.Attributes)
+		}
+
+		fmt.Fprint(w, `{"data":{"id":"9","type":"libraryEntries","attributes":{"status":"current","progress":12}}}`)
+	})
+
+	got, _, err := client.LibraryEntries.Update("9", &LibraryEntry{Progress: 12})
+	if err != nil {
+		t.Fatalf("LibraryEntries.Update returned error: %v", err)
+	}
+
+	if want := 12; got.Progress != want {
+		t.Errorf("LibraryEntries.Update Progress = %d, want %d", got.Progress, want)
+	}
+}
+
+func TestLibraryEntryService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"library-entries/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.LibraryEntries.Delete("9")
+	if err != nil {
+		t.Errorf("LibraryEntries.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package kitsu
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/nstratos/jsonapi"
+)
+
+// offsets holds the page[offset] values parsed out of a JSON API links
+// object, for the first, last, previous and next pages of a listing.
+type offsets struct {
+	first, last, prev, next int
+}
+
+// parseOffset extracts the page[offset] query parameter from each URL found
+// in links. A link that is absent, or that has no page[offset] parameter,
+// leaves the corresponding offset at 0.
+func parseOffset(links jsonapi.Links) (offsets, error) {
+	var o offsets
+
+	fields := map[string]*int{
+		"first": &o.first,
+		"last":  &o.last,
+		"prev":  &o.prev,
+		"next":  &o.next,
+	}
+	for key, dst := range fields {
+		raw, ok := links[key]
+		if !ok {
+			continue
+		}
+		s, ok := raw.(string)
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(s)
+		if err != nil {
+			return o, err
+		}
+		offset := u.Query().Get("page[offset]")
+		if offset == "" {
+			continue
+		}
+		n, err := strconv.Atoi(offset)
+		if err != nil {
+			return o, err
+		}
+		*dst = n
+	}
+
+	return o, nil
+}
+
+// linkString returns the verbatim URL stored under key in links, or "" if
+// key is absent or not a string.
+func linkString(links jsonapi.Links, key string) string {
+	raw, ok := links[key]
+	if !ok {
+		return ""
+	}
+	s, _ := raw.(string)
+	return s
+}
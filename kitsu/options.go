@@ -0,0 +1,43 @@
+package kitsu
+
+// Options holds the most commonly used parameters for listing a Kitsu
+// resource: pagination, filtering, sorting and included relationships. It is
+// a convenience alternative to passing urlOption funcs directly; List
+// methods accept it in place of Pagination/Filter/Sort/Include.
+type Options struct {
+	PageLimit  int
+	PageOffset int
+
+	Filter    string
+	FilterVal []string
+
+	Sort []string
+
+	Include []string
+}
+
+// urlOptions converts o into the equivalent urlOption funcs understood by
+// Client.NewRequest. A nil *Options yields no options.
+func (o *Options) urlOptions() []urlOption {
+	if o == nil {
+		return nil
+	}
+
+	var opts []urlOption
+	if o.PageLimit != 0 {
+		opts = append(opts, Limit(o.PageLimit))
+	}
+	if o.PageOffset != 0 || o.PageLimit != 0 {
+		opts = append(opts, Offset(o.PageOffset))
+	}
+	if o.Filter != "" {
+		opts = append(opts, Filter(o.Filter, o.FilterVal...))
+	}
+	if len(o.Sort) > 0 {
+		opts = append(opts, Sort(o.Sort...))
+	}
+	if len(o.Include) > 0 {
+		opts = append(opts, Include(o.Include...))
+	}
+	return opts
+}
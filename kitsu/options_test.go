@@ -0,0 +1,22 @@
+package kitsu
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestOptions_urlOptions_offsetOnly(t *testing.T) {
+	o := &Options{PageOffset: 10}
+
+	v := url.Values{}
+	for _, opt := range o.urlOptions() {
+		opt(&v)
+	}
+
+	if got, ok := v["page[limit]"]; ok {
+		t.Errorf("urlOptions() set page[limit] = %v with PageLimit left at its zero value, want it unset", got)
+	}
+	if got, want := v.Get("page[offset]"), "10"; got != want {
+		t.Errorf("urlOptions() page[offset] = %q, want %q", got, want)
+	}
+}
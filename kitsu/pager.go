@@ -0,0 +1,137 @@
+package kitsu
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+)
+
+// Pager iterates over successive pages of a list endpoint by following the
+// JSON:API links.next/links.prev URLs returned by the server verbatim,
+// rather than reconstructing them from Options. This keeps cursor-style
+// paging working even if a resource switches from offset to keyset
+// pagination. Pager is embedded by resource-specific pagers such as
+// AnimePager and UserPager, which add typed Next/Prev methods.
+type Pager struct {
+	client *Client
+	typ    reflect.Type
+	err    error
+
+	firstLink string
+	nextLink  string
+	prevLink  string
+}
+
+// newPager builds a Pager whose first Next() call issues req. buildErr is the
+// error, if any, returned while constructing req.
+func newPager(client *Client, typ reflect.Type, req *http.Request, buildErr error) *Pager {
+	p := &Pager{client: client, typ: typ, err: buildErr}
+	if req != nil {
+		p.firstLink = req.URL.String()
+		p.nextLink = p.firstLink
+	}
+	return p
+}
+
+// HasNext reports whether a next page is available.
+func (p *Pager) HasNext() bool { return p.nextLink != "" }
+
+// HasPrev reports whether a previous page is available.
+func (p *Pager) HasPrev() bool { return p.prevLink != "" }
+
+// Reset rewinds the pager back to its first page, so the next call to Next
+// returns page one again instead of continuing from wherever the pager had
+// last fetched.
+func (p *Pager) Reset() {
+	p.nextLink = p.firstLink
+	p.prevLink = ""
+}
+
+func (p *Pager) fetch(ctx context.Context, link string) ([]interface{}, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if link == "" {
+		return nil, nil
+	}
+
+	req, err := http.NewRequest("GET", link, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", defaultMediaType)
+
+	v, resp, err := p.client.DoManyWithContext(ctx, req, p.typ)
+	if err != nil {
+		return nil, err
+	}
+	p.nextLink = resp.NextLink
+	p.prevLink = resp.PrevLink
+	return v, nil
+}
+
+// AnimePager iterates over pages of Anime, as returned by
+// AnimeService.ListPages.
+type AnimePager struct {
+	*Pager
+}
+
+// Next fetches the next page of anime, or (nil, nil) if HasNext is false.
+func (p *AnimePager) Next(ctx context.Context) ([]*Anime, error) {
+	v, err := p.fetch(ctx, p.nextLink)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return toAnime(v), nil
+}
+
+// Prev fetches the previous page of anime, or (nil, nil) if HasPrev is
+// false.
+func (p *AnimePager) Prev(ctx context.Context) ([]*Anime, error) {
+	v, err := p.fetch(ctx, p.prevLink)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return toAnime(v), nil
+}
+
+func toAnime(v []interface{}) []*Anime {
+	anime := make([]*Anime, 0, len(v))
+	for _, a := range v {
+		anime = append(anime, a.(*Anime))
+	}
+	return anime
+}
+
+// UserPager iterates over pages of User, as returned by
+// UserService.ListPages.
+type UserPager struct {
+	*Pager
+}
+
+// Next fetches the next page of users, or (nil, nil) if HasNext is false.
+func (p *UserPager) Next(ctx context.Context) ([]*User, error) {
+	v, err := p.fetch(ctx, p.nextLink)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return toUsers(v), nil
+}
+
+// Prev fetches the previous page of users, or (nil, nil) if HasPrev is
+// false.
+func (p *UserPager) Prev(ctx context.Context) ([]*User, error) {
+	v, err := p.fetch(ctx, p.prevLink)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return toUsers(v), nil
+}
+
+func toUsers(v []interface{}) []*User {
+	users := make([]*User, 0, len(v))
+	for _, u := range v {
+		users = append(users, u.(*User))
+	}
+	return users
+}
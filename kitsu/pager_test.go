@@ -0,0 +1,70 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAnimePager_Next(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"anime", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `
+		{
+			"data": [{"id": "1", "type": "anime", "attributes": {"canonicalTitle": "page one"}}],
+			"links": {"next": "%[1]s/anime/page2"}
+		}`, server.URL)
+	})
+
+	mux.HandleFunc("/anime/page2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `
+		{
+			"data": [{"id": "2", "type": "anime", "attributes": {"canonicalTitle": "page two"}}]
+		}`)
+	})
+
+	pager := client.Anime.ListPages(nil)
+	if !pager.HasNext() {
+		t.Fatal("expected pager to have a next page before the first fetch")
+	}
+
+	page1, err := pager.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.Next returned error: %v", err)
+	}
+	if len(page1) != 1 || page1[0].ID != "1" {
+		t.Errorf("page1 = %#v, want one anime with ID 1", page1)
+	}
+	if !pager.HasNext() {
+		t.Fatal("expected pager to have a next page after the first fetch")
+	}
+
+	page2, err := pager.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.Next returned error: %v", err)
+	}
+	if len(page2) != 1 || page2[0].ID != "2" {
+		t.Errorf("page2 = %#v, want one anime with ID 2", page2)
+	}
+	if pager.HasNext() {
+		t.Error("expected pager to have no next page once links.next is absent")
+	}
+
+	pager.Reset()
+	if !pager.HasNext() {
+		t.Fatal("expected Reset to rewind the pager back to page one")
+	}
+
+	page1Again, err := pager.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Pager.Next after Reset returned error: %v", err)
+	}
+	if len(page1Again) != 1 || page1Again[0].ID != "1" {
+		t.Errorf("page after Reset = %#v, want one anime with ID 1", page1Again)
+	}
+}
@@ -0,0 +1,98 @@
+package kitsu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_Do_retriesOn429(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/"+defaultAPIVersion+"users/1", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"1","type":"users","attributes":{"name":"kita"}}}`)
+	})
+
+	got, _, err := client.User.Show("1")
+	if err != nil {
+		t.Fatalf("User.Show returned error: %v", err)
+	}
+	if got.Name != "kita" {
+		t.Errorf("User.Show Name = %q, want %q", got.Name, "kita")
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("handler was called %d times, want 2 (1 retry)", n)
+	}
+}
+
+func TestClient_Do_givesUpAfterMaxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.MaxRetries = 1
+
+	var calls int32
+	mux.HandleFunc("/"+defaultAPIVersion+"users/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := client.User.Show("1")
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("handler was called %d times, want 2 (1 initial + 1 retry)", n)
+	}
+}
+
+func TestClient_RateLimits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "HEAD")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+	})
+
+	rl, err := client.RateLimits(context.Background())
+	if err != nil {
+		t.Fatalf("RateLimits returned error: %v", err)
+	}
+	if rl.Limit != 100 || rl.Remaining != 42 {
+		t.Errorf("RateLimits() = %+v, want Limit=100 Remaining=42", rl)
+	}
+}
+
+func TestResponse_Rate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+defaultAPIVersion+"users/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		fmt.Fprint(w, `{"data":{"id":"1","type":"users","attributes":{"name":"kita"}}}`)
+	})
+
+	_, resp, err := client.User.Show("1")
+	if err != nil {
+		t.Fatalf("User.Show returned error: %v", err)
+	}
+
+	if resp.Rate.Limit != 100 || resp.Rate.Remaining != 42 {
+		t.Errorf("resp.Rate = %+v, want Limit=100 Remaining=42", resp.Rate)
+	}
+}
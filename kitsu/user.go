@@ -0,0 +1,82 @@
+package kitsu
+
+import (
+	"context"
+	"reflect"
+)
+
+// User represents a Kitsu user profile.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/user
+type User struct {
+	ID        string `jsonapi:"primary,users"`
+	Name      string `jsonapi:"attr,name,omitempty"`
+	LifeSpent int    `jsonapi:"attr,lifeSpentOnAnime,omitempty"`
+}
+
+// UserService handles communication with the user related methods of the
+// Kitsu API.
+//
+// JSON API docs: https://kitsu.docs.apiary.io/#reference/user
+type UserService service
+
+// Show returns the user identified by id.
+//
+// Show is a thin wrapper around ShowWithContext using context.Background,
+// kept for callers that don't need cancellation.
+func (s *UserService) Show(id string) (*User, *Response, error) {
+	return s.ShowWithContext(context.Background(), id)
+}
+
+// ShowWithContext behaves like Show but carries ctx through the request, so
+// a slow response can be cancelled instead of hanging the caller.
+func (s *UserService) ShowWithContext(ctx context.Context, id string) (*User, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "users/"+id, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var u User
+	resp, err := s.client.DoWithContext(ctx, req, &u)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &u, resp, nil
+}
+
+// List returns a list of users, optionally filtered, sorted, paginated and
+// expanded according to opt.
+//
+// List is a thin wrapper around ListWithContext using context.Background,
+// kept for callers that don't need cancellation.
+func (s *UserService) List(opt *Options) ([]*User, *Response, error) {
+	return s.ListWithContext(context.Background(), opt)
+}
+
+// ListWithContext behaves like List but carries ctx through the request, so
+// a slow response can be cancelled instead of hanging the caller.
+func (s *UserService) ListWithContext(ctx context.Context, opt *Options) ([]*User, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "users", nil, opt.urlOptions()...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, resp, err := s.client.DoManyWithContext(ctx, req, reflect.TypeOf(new(User)))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var users []*User
+	for _, u := range v {
+		users = append(users, u.(*User))
+	}
+	return users, resp, nil
+}
+
+// ListPages returns a Pager over the user listing described by opt. Use
+// UserPager.Next/Prev to follow the server's links.next/links.prev cursors
+// instead of reconstructing page requests from Options.
+func (s *UserService) ListPages(opt *Options) *UserPager {
+	req, err := s.client.NewRequest("GET", "users", nil, opt.urlOptions()...)
+	return &UserPager{newPager(s.client, reflect.TypeOf(new(User)), req, err)}
+}